@@ -0,0 +1,96 @@
+// Package cache provides a small in-process TTL cache with single-flight
+// request deduplication, used to shield upstream providers (e.g.
+// Codeforces) from repeated identical requests.
+package cache
+
+import (
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"sync"
+)
+
+// Stats reports point-in-time cache counters.
+type Stats struct {
+	Hits     int64 `json:"hits"`
+	Misses   int64 `json:"misses"`
+	Inflight int64 `json:"inflight"`
+}
+
+// Cache is a TTL cache keyed by string, with concurrent fetches for the
+// same key collapsed into one upstream call via singleflight.
+type Cache struct {
+	ttl time.Duration
+
+	mu      sync.RWMutex
+	entries map[string]entry
+
+	group singleflight.Group
+
+	hits, misses, inflight int64
+}
+
+type entry struct {
+	value     any
+	expiresAt time.Time
+}
+
+// New returns a Cache whose entries expire ttl after they're written.
+func New(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:     ttl,
+		entries: map[string]entry{},
+	}
+}
+
+// Get returns the cached value for key, if present and not expired.
+func (c *Cache) Get(key string) (any, bool) {
+	c.mu.RLock()
+	e, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	if !ok || time.Now().After(e.expiresAt) {
+		atomic.AddInt64(&c.misses, 1)
+		return nil, false
+	}
+	atomic.AddInt64(&c.hits, 1)
+	return e.value, true
+}
+
+// GetOrFetch returns the cached value for key, calling fetch to populate
+// it on a miss. Concurrent calls for the same key share a single
+// in-flight call to fetch.
+func (c *Cache) GetOrFetch(key string, fetch func() (any, error)) (any, error) {
+	if value, ok := c.Get(key); ok {
+		return value, nil
+	}
+
+	value, err, _ := c.group.Do(key, func() (any, error) {
+		atomic.AddInt64(&c.inflight, 1)
+		defer atomic.AddInt64(&c.inflight, -1)
+		return fetch()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.set(key, value)
+	return value, nil
+}
+
+func (c *Cache) set(key string, value any) {
+	c.mu.Lock()
+	c.entries[key] = entry{value: value, expiresAt: time.Now().Add(c.ttl)}
+	c.mu.Unlock()
+}
+
+// Stats returns a snapshot of the cache's hit/miss/inflight counters.
+func (c *Cache) Stats() Stats {
+	return Stats{
+		Hits:     atomic.LoadInt64(&c.hits),
+		Misses:   atomic.LoadInt64(&c.misses),
+		Inflight: atomic.LoadInt64(&c.inflight),
+	}
+}
@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetOrFetchCachesUntilTTLExpires(t *testing.T) {
+	c := New(20 * time.Millisecond)
+
+	var calls int64
+	fetch := func() (any, error) {
+		atomic.AddInt64(&calls, 1)
+		return "value", nil
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.GetOrFetch("key", fetch); err != nil {
+			t.Fatalf("GetOrFetch: %v", err)
+		}
+	}
+	if got := atomic.LoadInt64(&calls); got != 1 {
+		t.Errorf("fetch called %d times before TTL expiry, want 1", got)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if _, err := c.GetOrFetch("key", fetch); err != nil {
+		t.Fatalf("GetOrFetch after expiry: %v", err)
+	}
+	if got := atomic.LoadInt64(&calls); got != 2 {
+		t.Errorf("fetch called %d times after TTL expiry, want 2", got)
+	}
+
+	stats := c.Stats()
+	if stats.Misses != 2 {
+		t.Errorf("Misses = %d, want 2", stats.Misses)
+	}
+	if stats.Hits != 2 {
+		t.Errorf("Hits = %d, want 2", stats.Hits)
+	}
+}
+
+func TestGetOrFetchCollapsesConcurrentCalls(t *testing.T) {
+	c := New(time.Minute)
+
+	var calls int64
+	entered := make(chan struct{}, 1)
+	release := make(chan struct{})
+	fetch := func() (any, error) {
+		atomic.AddInt64(&calls, 1)
+		entered <- struct{}{}
+		<-release
+		return "value", nil
+	}
+
+	const concurrency = 10
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetOrFetch("key", fetch); err != nil {
+				t.Errorf("GetOrFetch: %v", err)
+			}
+		}()
+	}
+
+	// Wait for the one call that actually invokes fetch to be blocked
+	// inside it, then give the other goroutines time to join it as
+	// followers of the same in-flight singleflight call, before letting
+	// fetch return.
+	<-entered
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt64(&calls); got != 1 {
+		t.Errorf("fetch called %d times for concurrent requests, want 1", got)
+	}
+}
+
+func TestGetOrFetchDoesNotCacheErrors(t *testing.T) {
+	c := New(time.Minute)
+
+	var calls int64
+	fetch := func() (any, error) {
+		atomic.AddInt64(&calls, 1)
+		return nil, errFetch
+	}
+
+	if _, err := c.GetOrFetch("key", fetch); err != errFetch {
+		t.Fatalf("GetOrFetch err = %v, want errFetch", err)
+	}
+	if _, err := c.GetOrFetch("key", fetch); err != errFetch {
+		t.Fatalf("GetOrFetch err = %v, want errFetch", err)
+	}
+	if got := atomic.LoadInt64(&calls); got != 2 {
+		t.Errorf("fetch called %d times, want 2 (errors should not be cached)", got)
+	}
+}
+
+var errFetch = fetchError{}
+
+type fetchError struct{}
+
+func (fetchError) Error() string { return "fetch failed" }
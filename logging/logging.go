@@ -0,0 +1,39 @@
+// Package logging provides the request-scoped structured logger used
+// throughout the service. Each incoming request gets its own *slog.Logger
+// carrying a request_id field, stashed in its context.Context, so every
+// log line emitted while handling that request — including from fetch
+// functions several calls deep — can be correlated back to it.
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+)
+
+type contextKey int
+
+const loggerKey contextKey = iota
+
+// NewRequestID returns a short random hex string suitable for
+// correlating the logs of a single request.
+func NewRequestID() string {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// WithLogger returns a copy of ctx carrying logger.
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey, logger)
+}
+
+// FromContext returns the logger stashed in ctx by WithLogger, or
+// slog.Default() if ctx carries none.
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
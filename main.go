@@ -5,221 +5,451 @@
 //
 // Current Scope
 //
-// At present, the implementation supports fetching questions exclusively from
-// Codeforces. The design intentionally keeps the data source abstract so that
-// additional platforms can be integrated in the future with minimal changes.
+// Problem sources are implemented as pluggable providers (see the
+// providers package). Codeforces is the only provider registered today,
+// but others can be added without changing any handler code.
 
 package main
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
-	"io"
-	"log"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
-)
 
-const (
-	CODEFORCES_BASE_URL = "https://codeforces.com/api/"
-	// To access the data you just send a HTTP-request to address
-	// https://codeforces.com/api/{methodName} with method-specific parameters.
-	PROBLEMSET_METHOD = "problemset.problems"
+	"golang.org/x/time/rate"
+
+	"github.com/soorya38/problem-discovery-service/cache"
+	"github.com/soorya38/problem-discovery-service/logging"
+	"github.com/soorya38/problem-discovery-service/providers"
+	_ "github.com/soorya38/problem-discovery-service/providers/codeforces"
+	"github.com/soorya38/problem-discovery-service/ratelimit"
 )
 
-// available tags on codeforces
-var CodeForcesTags = []string{
-	"dp", "greedy", "math", "geometry", "string",
-	"data structures", "trees", "graphs", "sorting", "binary search",
-	"hashing", "bitmasks", "dp", "trees", "graphs", "sorting",
-	"binary search", "hashing", "bitmasks",
-}
+// defaultProvider is used when a request does not specify ?provider=.
+const defaultProvider = "codeforces"
 
-// represents a problem object from codeforces response
-type Problem struct {
-	ContestID      int      `json:"contestId"`
-	ProblemSetName string   `json:"problemsetName"`
-	Index          string   `json:"index"`
-	Name           string   `json:"name"`
-	Type           string   `json:"type"`
-	Points         float64  `json:"points"`
-	Rating         int      `json:"rating"`
-	Tags           []string `json:"tags"`
-}
+// aggregateWorkerPoolSize bounds how many providers are fetched from
+// concurrently when handling /problems/aggregate.
+const aggregateWorkerPoolSize = 4
+
+// problemCacheTTL controls how long a provider's response for a given
+// tag query is reused before it is fetched again.
+const problemCacheTTL = 10 * time.Minute
+
+// problemCache caches provider responses keyed by provider+query, and
+// collapses concurrent requests for the same key into one upstream call.
+var problemCache = cache.New(problemCacheTTL)
+
+// Default per-client rate limit, overridable via the RATE_LIMIT_RPM and
+// RATE_LIMIT_BURST env vars.
+const (
+	defaultRateLimitRPM   = 30
+	defaultRateLimitBurst = 10
+)
 
-// represents a response from codeforces API
-type CodeforcesResponse struct {
-	Status string `json:"status"`
-	Result struct {
-		Problems []Problem `json:"problems"`
-	} `json:"result"`
+// rateLimitFromEnv builds the configured per-client rate limit, falling
+// back to the defaults above when the env vars are unset or invalid.
+func rateLimitFromEnv() (rate.Limit, int) {
+	rpm := envInt("RATE_LIMIT_RPM", defaultRateLimitRPM)
+	burst := envInt("RATE_LIMIT_BURST", defaultRateLimitBurst)
+	return rate.Limit(float64(rpm) / 60), burst
 }
 
-// fetchCodeforcesProblemSetWithTag fetches a list of problems based on the provided tag
-// this method returns all the problems with the provided tag with other tags too
-func fetchCodeforcesProblemSetWithTag(tag string) ([]Problem, error) {
-	url := CODEFORCES_BASE_URL + PROBLEMSET_METHOD + "?tags=" + tag
-	response, err := http.Get(url)
-	if err != nil {
-		log.Printf("Error fetching problem set, err=%v", err)
-		return nil, err
+// envInt reads an int env var, falling back to fallback if it is unset
+// or not a valid integer.
+func envInt(name string, fallback int) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
 	}
-	defer response.Body.Close()
-
-	body, err := io.ReadAll(response.Body)
+	parsed, err := strconv.Atoi(value)
 	if err != nil {
-		log.Printf("Error reading problem set, err=%v", err)
-		return nil, err
+		slog.Warn("invalid env var, using default", "name", name, "value", value, "default", fallback)
+		return fallback
 	}
+	return parsed
+}
 
-	var codeforcesResponse CodeforcesResponse
-	err = json.Unmarshal(body, &codeforcesResponse)
-	if err != nil {
-		log.Printf("Error unmarshalling problem set, err=%v", err)
-		return nil, err
-	}
+// <--------------------------------- handlers --------------------------------->
 
-	// sort the problems by rating
-	sort.Slice(codeforcesResponse.Result.Problems, func(i, j int) bool {
-		return codeforcesResponse.Result.Problems[i].Rating < codeforcesResponse.Result.Problems[j].Rating
-	})
+// resolveProvider resolves the ?provider= query parameter to a registered
+// Provider, defaulting to defaultProvider, and writes an error response
+// if the name is unknown.
+func resolveProvider(w http.ResponseWriter, r *http.Request) (providers.Provider, bool) {
+	name := r.URL.Query().Get("provider")
+	if name == "" {
+		name = defaultProvider
+	}
 
-	return codeforcesResponse.Result.Problems, nil
+	provider, ok := providers.Get(name)
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown provider %q", name), http.StatusBadRequest)
+		return nil, false
+	}
+	return provider, true
 }
 
-// fetchCodeforcesProblemSetWithTagOnly fetches a list of problems based on the provided tag
-// this method returns only problems with the provided tag only
-func fetchCodeforcesProblemSetWithTagOnly(tag string) ([]Problem, error) {
-	allProblems, err := fetchCodeforcesProblemSetWithTag(tag)
+// fetchProblems fetches problems for query from provider, serving from
+// problemCache when a fresh response is already cached.
+func fetchProblems(ctx context.Context, provider providers.Provider, query providers.Query) ([]providers.Problem, error) {
+	key := cacheKey(provider.Name(), query)
+	value, err := problemCache.GetOrFetch(key, func() (any, error) {
+		return provider.Fetch(ctx, query)
+	})
 	if err != nil {
-		log.Printf("Error fetching problem set, err=%v", err)
 		return nil, err
 	}
+	return value.([]providers.Problem), nil
+}
 
-	var problems []Problem
-	for _, problem := range allProblems {
-		if len(problem.Tags) == 1 {
-			problems = append(problems, problem)
-		}
-	}
-	return problems, nil
+// cacheKey builds a cache key that is stable regardless of tag order.
+func cacheKey(providerName string, query providers.Query) string {
+	tags := append([]string(nil), query.Tags...)
+	sort.Strings(tags)
+	return providerName + "|" + strings.Join(tags, ",") + "|" + strconv.FormatBool(query.ExactTags)
 }
 
-// fetchCodeforcesProblemSetWithTags fetches a list of problems based on the provided tags
-// this method returns problems with all the provided tags
-func fetchCodeforcesProblemSetWithTags(tags []string) ([]Problem, error) {
-	url := CODEFORCES_BASE_URL + PROBLEMSET_METHOD + "?tags=" + strings.Join(tags, ";")
-	response, err := http.Get(url)
+// writeProblems JSON-encodes problems to w, setting an ETag so clients
+// can revalidate with If-None-Match instead of re-fetching the body.
+func writeProblems(w http.ResponseWriter, r *http.Request, problems []providers.Problem) {
+	etag, err := etagFor(problems)
 	if err != nil {
-		log.Printf("Error fetching problem set, err=%v", err)
-		return nil, err
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
-	defer response.Body.Close()
+	w.Header().Set("ETag", etag)
 
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		log.Printf("Error reading problem set, err=%v", err)
-		return nil, err
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
 	}
 
-	var codeforcesResponse CodeforcesResponse
-	err = json.Unmarshal(body, &codeforcesResponse)
+	body, err := json.Marshal(problems)
 	if err != nil {
-		log.Printf("Error unmarshalling problem set, err=%v", err)
-		return nil, err
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
-
-	// sort the problems by rating
-	sort.Slice(codeforcesResponse.Result.Problems, func(i, j int) bool {
-		return codeforcesResponse.Result.Problems[i].Rating < codeforcesResponse.Result.Problems[j].Rating
-	})
-
-	return codeforcesResponse.Result.Problems, nil
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
 }
 
-// fetchCodeforcesProblemSetWithTagsOnly fetches a list of problems based on the provided tags
-// this method returns only problems with the provided tags only
-func fetchCodeforcesProblemSetWithTagsOnly(tags []string) ([]Problem, error) {
-	allProblems, err := fetchCodeforcesProblemSetWithTags(tags)
+// etagFor computes a strong ETag from the JSON representation of problems.
+func etagFor(problems []providers.Problem) (string, error) {
+	body, err := json.Marshal(problems)
 	if err != nil {
-		log.Printf("Error fetching problem set, err=%v", err)
-		return nil, err
-	}
-
-	var problems []Problem
-	for _, problem := range allProblems {
-		if len(problem.Tags) == len(tags) {
-			problems = append(problems, problem)
-		}
+		return "", err
 	}
-	return problems, nil
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:]) + `"`, nil
 }
 
-// <--------------------------------- handlers --------------------------------->
-
 // problemsByTagHandler is a handler to get problems by tag
 func problemsByTagHandler(w http.ResponseWriter, r *http.Request) {
-	tag := r.URL.Query().Get("tag")
-	problems, err := fetchCodeforcesProblemSetWithTag(tag)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	provider, ok := resolveProvider(w, r)
+	if !ok {
 		return
 	}
-	if err := json.NewEncoder(w).Encode(problems); err != nil {
+
+	tag := r.URL.Query().Get("tag")
+	problems, err := fetchProblems(r.Context(), provider, providers.Query{Tags: []string{tag}})
+	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	w.WriteHeader(http.StatusOK)
+	writeProblems(w, r, problems)
 }
 
 // problemsByTagsHandler is a handler to get problems by tags
 func problemsByTagsHandler(w http.ResponseWriter, r *http.Request) {
-	tags := r.URL.Query().Get("tags")
-	problems, err := fetchCodeforcesProblemSetWithTags(strings.Split(tags, ","))
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	provider, ok := resolveProvider(w, r)
+	if !ok {
 		return
 	}
-	if err := json.NewEncoder(w).Encode(problems); err != nil {
+
+	tags := r.URL.Query().Get("tags")
+	problems, err := fetchProblems(r.Context(), provider, providers.Query{Tags: strings.Split(tags, ",")})
+	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	w.WriteHeader(http.StatusOK)
+	writeProblems(w, r, problems)
 }
 
 // problemsByTagOnlyHandler is a handler to get problems by tag only
 func problemsByTagOnlyHandler(w http.ResponseWriter, r *http.Request) {
-	tag := r.URL.Query().Get("tag")
-	problems, err := fetchCodeforcesProblemSetWithTagOnly(tag)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	provider, ok := resolveProvider(w, r)
+	if !ok {
 		return
 	}
-	if err := json.NewEncoder(w).Encode(problems); err != nil {
+
+	tag := r.URL.Query().Get("tag")
+	problems, err := fetchProblems(r.Context(), provider, providers.Query{Tags: []string{tag}, ExactTags: true})
+	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	w.WriteHeader(http.StatusOK)
+	writeProblems(w, r, problems)
 }
 
 // problemsByTagsOnlyHandler is a handler to get problems by tags only
 func problemsByTagsOnlyHandler(w http.ResponseWriter, r *http.Request) {
+	provider, ok := resolveProvider(w, r)
+	if !ok {
+		return
+	}
+
 	tags := r.URL.Query().Get("tags")
-	problems, err := fetchCodeforcesProblemSetWithTagsOnly(strings.Split(tags, ","))
+	problems, err := fetchProblems(r.Context(), provider, providers.Query{Tags: strings.Split(tags, ","), ExactTags: true})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	if err := json.NewEncoder(w).Encode(problems); err != nil {
+	writeProblems(w, r, problems)
+}
+
+// problemsAggregateHandler fans a query out across every registered
+// provider concurrently, using a bounded worker pool, and merges the
+// results sorted by normalized difficulty.
+func problemsAggregateHandler(w http.ResponseWriter, r *http.Request) {
+	tags := r.URL.Query().Get("tags")
+	if tags == "" {
+		http.Error(w, "tags query parameter is required", http.StatusBadRequest)
+		return
+	}
+	query := providers.Query{Tags: strings.Split(tags, ",")}
+
+	names := providers.Names()
+	if len(names) == 0 {
+		http.Error(w, "no providers registered", http.StatusInternalServerError)
+		return
+	}
+
+	type fetchResult struct {
+		problems []providers.Problem
+		err      error
+	}
+
+	results := make([]fetchResult, len(names))
+	sem := make(chan struct{}, aggregateWorkerPoolSize)
+	var wg sync.WaitGroup
+
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			provider, ok := providers.Get(name)
+			if !ok {
+				results[i] = fetchResult{err: fmt.Errorf("provider %q not found", name)}
+				return
+			}
+
+			problems, err := fetchProblems(r.Context(), provider, query)
+			results[i] = fetchResult{problems: problems, err: err}
+		}(i, name)
+	}
+	wg.Wait()
+
+	groups := make([][]providers.Problem, 0, len(results))
+	for i, result := range results {
+		if result.err != nil {
+			logging.FromContext(r.Context()).Error("aggregate fetch failed", "provider", names[i], "err", result.err)
+			continue
+		}
+		groups = append(groups, result.problems)
+	}
+
+	merged := mergeByNormalizedDifficulty(groups)
+	writeProblems(w, r, merged)
+}
+
+// statsHandler reports problemCache hit/miss/inflight counters.
+func statsHandler(w http.ResponseWriter, _ *http.Request) {
+	body, err := json.Marshal(problemCache.Stats())
+	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// mergeByNormalizedDifficulty merges several providers' problem lists into
+// one, ordered by rating scaled into [0, 1] *within each provider's own
+// min/max*. A single global min/max would just be a monotonic transform of
+// Rating and sort identically to raw rating; normalizing per provider is
+// what actually lets providers with different rating scales interleave
+// sensibly.
+func mergeByNormalizedDifficulty(groups [][]providers.Problem) []providers.Problem {
+	type scored struct {
+		problem    providers.Problem
+		normalized float64
+	}
+
+	var all []scored
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+
+		min, max := group[0].Rating, group[0].Rating
+		for _, p := range group[1:] {
+			if p.Rating < min {
+				min = p.Rating
+			}
+			if p.Rating > max {
+				max = p.Rating
+			}
+		}
+		spread := max - min
+
+		for _, p := range group {
+			normalized := 0.0
+			if spread != 0 {
+				normalized = float64(p.Rating-min) / float64(spread)
+			}
+			all = append(all, scored{problem: p, normalized: normalized})
+		}
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].normalized < all[j].normalized
+	})
+
+	merged := make([]providers.Problem, len(all))
+	for i, s := range all {
+		merged[i] = s.problem
+	}
+	return merged
+}
+
+// streamHeartbeatInterval is how often problemsStreamHandler sends a
+// comment line to keep intermediaries from closing an idle connection.
+const streamHeartbeatInterval = 15 * time.Second
+
+// problemsStreamHandler speaks SSE: it writes each Problem as soon as its
+// provider returns it, rather than buffering the full result set, so a
+// slow provider doesn't hold up problems other providers already have.
+func problemsStreamHandler(w http.ResponseWriter, r *http.Request) {
+	tags := r.URL.Query().Get("tags")
+	if tags == "" {
+		http.Error(w, "tags query parameter is required", http.StatusBadRequest)
+		return
+	}
+	query := providers.Query{Tags: strings.Split(tags, ",")}
+
+	names := providers.Names()
+	if name := r.URL.Query().Get("provider"); name != "" {
+		if _, ok := providers.Get(name); !ok {
+			http.Error(w, fmt.Sprintf("unknown provider %q", name), http.StatusBadRequest)
+			return
+		}
+		names = []string{name}
+	}
+	if len(names) == 0 {
+		http.Error(w, "no providers registered", http.StatusInternalServerError)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	// The server's WriteTimeout applies to the whole response and is never
+	// reset by Flush, so left alone it tears the connection down long before
+	// a slow-moving stream finishes. Clear it here now that we know this is
+	// an SSE response, rather than disabling it server-wide.
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+		logging.FromContext(r.Context()).Error("stream set write deadline failed", "err", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ctx := r.Context()
+	problemCh := make(chan providers.Problem)
+
+	var wg sync.WaitGroup
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+
+			provider, ok := providers.Get(name)
+			if !ok {
+				return
+			}
+
+			problems, err := fetchProblems(ctx, provider, query)
+			if err != nil {
+				logging.FromContext(ctx).Error("stream fetch failed", "provider", name, "err", err)
+				return
+			}
+			for _, problem := range problems {
+				select {
+				case problemCh <- problem:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(name)
+	}
+	go func() {
+		wg.Wait()
+		close(problemCh)
+	}()
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case problem, open := <-problemCh:
+			if !open {
+				return
+			}
+			if err := writeSSEProblem(w, problem); err != nil {
+				logging.FromContext(ctx).Error("stream write failed", "err", err)
+				return
+			}
+			flusher.Flush()
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+// writeSSEProblem writes problem as a single SSE "data:" event.
+func writeSSEProblem(w http.ResponseWriter, problem providers.Problem) error {
+	body, err := json.Marshal(problem)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "data: %s\n\n", body)
+	return err
 }
 
 // <--------------------------------- server code --------------------------------->
@@ -234,10 +464,17 @@ func main() {
 	mux.HandleFunc("/problems/multi", problemsByTagsHandler)
 	mux.HandleFunc("/problems/only", problemsByTagOnlyHandler)
 	mux.HandleFunc("/problems/multi/only", problemsByTagsOnlyHandler)
+	mux.HandleFunc("/problems/aggregate", problemsAggregateHandler)
+	mux.HandleFunc("/problems/stream", problemsStreamHandler)
+	mux.HandleFunc("/problems/recommend", problemsRecommendHandler)
+	mux.HandleFunc("/stats", statsHandler)
+
+	rpm, burst := rateLimitFromEnv()
+	visitors := ratelimit.NewVisitorStore(rpm, burst)
 
 	server := &http.Server{
 		Addr:              ":" + PORT,
-		Handler:           loggingMiddleware(mux),
+		Handler:           loggingMiddleware(visitors.Middleware(mux)),
 		ReadTimeout:       5 * time.Second,
 		ReadHeaderTimeout: 2 * time.Second,
 		WriteTimeout:      10 * time.Second,
@@ -245,9 +482,10 @@ func main() {
 	}
 
 	go func() {
-		log.Println("HTTP server started on port=" + PORT)
+		slog.Info("HTTP server started", "port", PORT)
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("server error, err=%v", err)
+			slog.Error("server error", "err", err)
+			os.Exit(1)
 		}
 	}()
 
@@ -260,15 +498,63 @@ func healthHandler(w http.ResponseWriter, _ *http.Request) {
 	w.Write([]byte("ok"))
 }
 
-// loggingMiddleware is a middleware to log the requests
+// loggingMiddleware generates a request-scoped logger carrying a
+// correlation ID, stashes it in the request context so downstream fetch
+// functions' logs inherit it, and emits one structured line per request.
 func loggingMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
-		next.ServeHTTP(w, r)
-		log.Printf("%s %s %s", r.Method, r.URL.Path, time.Since(start))
+
+		requestID := logging.NewRequestID()
+		logger := slog.Default().With("request_id", requestID)
+		r = r.WithContext(logging.WithLogger(r.Context(), logger))
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		logger.Info("request handled",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", rec.status,
+			"bytes", rec.bytes,
+			"latency_ms", time.Since(start).Milliseconds(),
+		)
 	})
 }
 
+// statusRecorder wraps http.ResponseWriter to capture the status code and
+// byte count written, for logging.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (rec *statusRecorder) WriteHeader(status int) {
+	rec.status = status
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *statusRecorder) Write(b []byte) (int, error) {
+	n, err := rec.ResponseWriter.Write(b)
+	rec.bytes += n
+	return n, err
+}
+
+// Flush forwards to the underlying ResponseWriter's Flusher, if any, so
+// SSE handlers wrapped by this middleware can still stream incrementally.
+func (rec *statusRecorder) Flush() {
+	if flusher, ok := rec.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap exposes the underlying ResponseWriter so http.ResponseController
+// can reach deadline- and flush-related methods through this wrapper.
+func (rec *statusRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
+
 // shutdownGracefully is a function to shutdown the server gracefully
 // it waits for 10 seconds for the server to shutdown gracefully
 // if the server does not shutdown gracefully, it forcefully shuts down the server
@@ -277,13 +563,13 @@ func shutdownGracefully(server *http.Server) {
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 	<-stop
 
-	log.Println("shutting down server...")
+	slog.Info("shutting down server...")
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	if err := server.Shutdown(ctx); err != nil {
-		log.Printf("graceful shutdown failed, err=%v", err)
+		slog.Error("graceful shutdown failed", "err", err)
 	}
-	log.Println("server shutdown gracefully")
+	slog.Info("server shutdown gracefully")
 }
@@ -0,0 +1,138 @@
+// Package codeforces implements the providers.Provider interface on top
+// of the public Codeforces API.
+package codeforces
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/soorya38/problem-discovery-service/logging"
+	"github.com/soorya38/problem-discovery-service/providers"
+)
+
+const (
+	baseURL          = "https://codeforces.com/api/"
+	problemsetMethod = "problemset.problems"
+	providerName     = "codeforces"
+)
+
+// tags lists the tags available on Codeforces.
+var tags = []string{
+	"dp", "greedy", "math", "geometry", "string",
+	"data structures", "trees", "graphs", "sorting", "binary search",
+	"hashing", "bitmasks", "dp", "trees", "graphs", "sorting",
+	"binary search", "hashing", "bitmasks",
+}
+
+func init() {
+	providers.Register(providerName, func() providers.Provider { return &Provider{} })
+}
+
+// Provider is the Codeforces implementation of providers.Provider.
+type Provider struct{}
+
+// Name returns the provider's registry name.
+func (p *Provider) Name() string {
+	return providerName
+}
+
+// Tags returns the tags this provider knows how to search by.
+func (p *Provider) Tags(_ context.Context) ([]string, error) {
+	return tags, nil
+}
+
+// Fetch fetches problems matching query from the Codeforces API.
+func (p *Provider) Fetch(ctx context.Context, query providers.Query) ([]providers.Problem, error) {
+	if len(query.Tags) == 0 {
+		return nil, fmt.Errorf("codeforces: at least one tag is required")
+	}
+
+	logger := logging.FromContext(ctx).With("provider", providerName, "tag", strings.Join(query.Tags, ","))
+	start := time.Now()
+
+	url := baseURL + problemsetMethod + "?tags=" + strings.Join(query.Tags, ";")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("codeforces: building request: %w", err)
+	}
+
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Error("codeforces fetch failed", "latency_ms", time.Since(start).Milliseconds(), "err", err)
+		return nil, fmt.Errorf("codeforces: fetching problem set: %w", err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("codeforces: reading problem set: %w", err)
+	}
+
+	var apiResponse apiResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, fmt.Errorf("codeforces: unmarshalling problem set: %w", err)
+	}
+
+	problems := make([]providers.Problem, 0, len(apiResponse.Result.Problems))
+	for _, p := range apiResponse.Result.Problems {
+		if query.ExactTags && len(p.Tags) != len(query.Tags) {
+			continue
+		}
+		problems = append(problems, p.toProviderProblem())
+	}
+
+	sort.Slice(problems, func(i, j int) bool {
+		return problems[i].Rating < problems[j].Rating
+	})
+
+	logger.Info("codeforces fetch completed",
+		"latency_ms", time.Since(start).Milliseconds(),
+		"upstream_status", response.StatusCode,
+		"problem_count", len(problems),
+	)
+
+	return problems, nil
+}
+
+// apiResponse represents a response from the Codeforces API.
+type apiResponse struct {
+	Status string `json:"status"`
+	Result struct {
+		Problems []apiProblem `json:"problems"`
+	} `json:"result"`
+}
+
+// apiProblem represents a problem object as returned by Codeforces.
+type apiProblem struct {
+	ContestID      int      `json:"contestId"`
+	ProblemSetName string   `json:"problemsetName"`
+	Index          string   `json:"index"`
+	Name           string   `json:"name"`
+	Type           string   `json:"type"`
+	Points         float64  `json:"points"`
+	Rating         int      `json:"rating"`
+	Tags           []string `json:"tags"`
+}
+
+// toProviderProblem converts a Codeforces-specific problem into the
+// provider-neutral shape, moving the contest ID into ProviderMetadata.
+func (p apiProblem) toProviderProblem() providers.Problem {
+	return providers.Problem{
+		ProblemSetName: p.ProblemSetName,
+		Index:          p.Index,
+		Name:           p.Name,
+		Type:           p.Type,
+		Points:         p.Points,
+		Rating:         p.Rating,
+		Tags:           p.Tags,
+		ProviderMetadata: map[string]any{
+			"contestId": p.ContestID,
+		},
+	}
+}
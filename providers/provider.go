@@ -0,0 +1,96 @@
+// Package providers defines the pluggable interface that every problem
+// source (Codeforces, LeetCode, AtCoder, ...) implements, along with a
+// registry so new sources can be added without touching handler code.
+//
+// The registration pattern mirrors database/sql drivers: each provider
+// package calls Register from an init function, and callers look the
+// provider up by name at request time.
+package providers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Problem represents a single practice problem. It is intentionally
+// provider-neutral: fields that only make sense for one source (e.g.
+// Codeforces' numeric contest ID) live in ProviderMetadata instead of
+// being promoted to top-level fields.
+type Problem struct {
+	ProblemSetName   string         `json:"problemsetName"`
+	Index            string         `json:"index"`
+	Name             string         `json:"name"`
+	Type             string         `json:"type"`
+	Points           float64        `json:"points"`
+	Rating           int            `json:"rating"`
+	Tags             []string       `json:"tags"`
+	ProviderMetadata map[string]any `json:"providerMetadata,omitempty"`
+}
+
+// Query describes what a caller wants from a provider.
+type Query struct {
+	// Tags is the set of tags to search for.
+	Tags []string
+	// ExactTags restricts results to problems whose own tag set matches
+	// Tags exactly, rather than problems that merely include them.
+	ExactTags bool
+}
+
+// Provider is implemented by every problem source.
+type Provider interface {
+	// Name returns the provider's registry name, e.g. "codeforces".
+	Name() string
+	// Tags returns the set of tags this provider knows how to search by.
+	Tags(ctx context.Context) ([]string, error)
+	// Fetch returns the problems matching query.
+	Fetch(ctx context.Context, query Query) ([]Problem, error)
+}
+
+// Factory constructs a new Provider instance.
+type Factory func() Provider
+
+var (
+	mu       sync.RWMutex
+	registry = map[string]Factory{}
+)
+
+// Register makes a provider factory available under name. It panics if
+// called twice for the same name, analogous to sql.Register.
+func Register(name string, factory Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if factory == nil {
+		panic("providers: Register factory is nil")
+	}
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("providers: Register called twice for provider %q", name))
+	}
+	registry[name] = factory
+}
+
+// Get returns a new instance of the named provider, if registered.
+func Get(name string) (Provider, bool) {
+	mu.RLock()
+	factory, ok := registry[name]
+	mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return factory(), true
+}
+
+// Names returns the names of every registered provider, sorted.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
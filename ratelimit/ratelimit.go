@@ -0,0 +1,101 @@
+// Package ratelimit implements per-client IP rate limiting for the HTTP
+// handlers, so that a single client can't hammer /problems and get the
+// upstream provider IP-blocked. It borrows the visitor-map pattern used
+// by projects like ntfy: one token-bucket limiter per client IP, with a
+// reaper goroutine that evicts visitors that have gone idle.
+package ratelimit
+
+import (
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// visitorTTL is how long a visitor may stay idle before the reaper
+// evicts it.
+const visitorTTL = 3 * time.Minute
+
+// reapInterval is how often the reaper sweeps for idle visitors.
+const reapInterval = time.Minute
+
+// visitor tracks one client's limiter and when it was last seen.
+type visitor struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// VisitorStore holds one rate.Limiter per client IP.
+type VisitorStore struct {
+	rate  rate.Limit
+	burst int
+
+	mu       sync.Mutex
+	visitors map[string]*visitor
+}
+
+// NewVisitorStore returns a VisitorStore that allows r requests per
+// second with bursts up to burst, per client IP, and starts its reaper
+// goroutine.
+func NewVisitorStore(r rate.Limit, burst int) *VisitorStore {
+	vs := &VisitorStore{
+		rate:     r,
+		burst:    burst,
+		visitors: map[string]*visitor{},
+	}
+	go vs.reapStaleVisitors()
+	return vs
+}
+
+// Middleware rejects requests from clients that have exceeded their rate
+// limit with 429 Too Many Requests, and otherwise passes through to next.
+func (vs *VisitorStore) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !vs.visitorFor(clientIP(r)).Allow() {
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// visitorFor returns the limiter for ip, creating one if this is the
+// first time ip has been seen.
+func (vs *VisitorStore) visitorFor(ip string) *rate.Limiter {
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+
+	v, ok := vs.visitors[ip]
+	if !ok {
+		v = &visitor{limiter: rate.NewLimiter(vs.rate, vs.burst)}
+		vs.visitors[ip] = v
+	}
+	v.lastSeen = time.Now()
+	return v.limiter
+}
+
+// reapStaleVisitors periodically evicts visitors that haven't been seen
+// in visitorTTL, so the map doesn't grow without bound.
+func (vs *VisitorStore) reapStaleVisitors() {
+	for range time.Tick(reapInterval) {
+		vs.mu.Lock()
+		for ip, v := range vs.visitors {
+			if time.Since(v.lastSeen) > visitorTTL {
+				delete(vs.visitors, ip)
+			}
+		}
+		vs.mu.Unlock()
+	}
+}
+
+// clientIP extracts the client IP from a request, falling back to the
+// raw RemoteAddr if it has no port to split off.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/soorya38/problem-discovery-service/logging"
+	"github.com/soorya38/problem-discovery-service/providers"
+)
+
+// Defaults for /problems/recommend when the caller omits a parameter.
+const (
+	defaultRecommendMinRating = 0
+	defaultRecommendMaxRating = 3500
+	defaultRecommendSpread    = 5
+)
+
+// ratingBucket is one equal-width sub-range of the requested rating band.
+type ratingBucket struct {
+	MinRating int `json:"minRating"`
+	MaxRating int `json:"maxRating"`
+	Count     int `json:"count"`
+}
+
+// recommendation is the response body for /problems/recommend.
+type recommendation struct {
+	Problems  []providers.Problem `json:"problems"`
+	Histogram []ratingBucket      `json:"histogram"`
+}
+
+// candidate is a problem paired with the index of the tag pool it came
+// from, so selection can round-robin across tags for topic variety.
+type candidate struct {
+	poolIndex int
+	problem   providers.Problem
+}
+
+// problemsRecommendHandler picks up to `spread` problems that spread
+// evenly across [min_rating, max_rating], round-robining across the
+// requested tags when more than one is given so topics vary too.
+func problemsRecommendHandler(w http.ResponseWriter, r *http.Request) {
+	provider, ok := resolveProvider(w, r)
+	if !ok {
+		return
+	}
+
+	tagsParam := r.URL.Query().Get("tags")
+	if tagsParam == "" {
+		http.Error(w, "tags query parameter is required", http.StatusBadRequest)
+		return
+	}
+	tags := strings.Split(tagsParam, ",")
+
+	minRating, err := queryIntParam(r, "min_rating", defaultRecommendMinRating)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	maxRating, err := queryIntParam(r, "max_rating", defaultRecommendMaxRating)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	spread, err := queryIntParam(r, "spread", defaultRecommendSpread)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if spread <= 0 {
+		http.Error(w, "spread must be positive", http.StatusBadRequest)
+		return
+	}
+	if minRating >= maxRating {
+		http.Error(w, "min_rating must be less than max_rating", http.StatusBadRequest)
+		return
+	}
+	if spread > maxRating-minRating {
+		http.Error(w, "spread must not exceed max_rating - min_rating", http.StatusBadRequest)
+		return
+	}
+
+	pools := fetchTagPools(r, provider, tags, minRating, maxRating)
+
+	body, err := json.Marshal(recommend(pools, minRating, maxRating, spread))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// fetchTagPools fetches one candidate pool per tag concurrently, bounded
+// by aggregateWorkerPoolSize. A tag whose fetch fails is logged and
+// treated as an empty pool rather than failing the whole request, so one
+// bad tag doesn't waste the results already fetched for the others.
+func fetchTagPools(r *http.Request, provider providers.Provider, tags []string, minRating, maxRating int) [][]providers.Problem {
+	pools := make([][]providers.Problem, len(tags))
+	sem := make(chan struct{}, aggregateWorkerPoolSize)
+	var wg sync.WaitGroup
+
+	for i, tag := range tags {
+		wg.Add(1)
+		go func(i int, tag string) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			problems, err := fetchProblems(r.Context(), provider, providers.Query{Tags: []string{tag}})
+			if err != nil {
+				logging.FromContext(r.Context()).Error("recommend fetch failed", "tag", tag, "err", err)
+				return
+			}
+			pools[i] = filterByRating(problems, minRating, maxRating)
+		}(i, tag)
+	}
+	wg.Wait()
+
+	return pools
+}
+
+// queryIntParam parses the named query parameter as an int, returning
+// fallback if it is absent.
+func queryIntParam(r *http.Request, name string, fallback int) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return fallback, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", name, value)
+	}
+	return parsed, nil
+}
+
+// filterByRating returns the subset of problems whose rating falls
+// within [min, max].
+func filterByRating(problems []providers.Problem, min, max int) []providers.Problem {
+	filtered := make([]providers.Problem, 0, len(problems))
+	for _, p := range problems {
+		if p.Rating >= min && p.Rating <= max {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered
+}
+
+// recommend buckets pools into spread equal-width rating sub-ranges and
+// picks one representative per bucket, round-robining across pools
+// (i.e. tags) and falling back to the nearest non-empty bucket when a
+// bucket has no candidates of its own.
+func recommend(pools [][]providers.Problem, minRating, maxRating, spread int) recommendation {
+	buckets := bucketBounds(minRating, maxRating, spread)
+
+	byBucket := make([][]candidate, spread)
+	for poolIndex, pool := range pools {
+		for _, problem := range pool {
+			idx := bucketIndex(problem.Rating, minRating, maxRating, spread)
+			byBucket[idx] = append(byBucket[idx], candidate{poolIndex: poolIndex, problem: problem})
+		}
+	}
+	for i := range buckets {
+		buckets[i].Count = len(byBucket[i])
+	}
+
+	used := make(map[string]bool)
+	poolCursor := 0
+	var chosen []providers.Problem
+
+	for i := 0; i < spread; i++ {
+		problem, ok := pickRoundRobin(byBucket[i], len(pools), &poolCursor, used)
+		if !ok {
+			problem, ok = pickFromNearestBucket(byBucket, i, used)
+		}
+		if ok {
+			chosen = append(chosen, problem)
+		}
+	}
+
+	return recommendation{Problems: chosen, Histogram: buckets}
+}
+
+// bucketBounds splits [min, max] into spread equal-width sub-ranges,
+// widening the last one to absorb any remainder.
+func bucketBounds(min, max, spread int) []ratingBucket {
+	width := (max - min) / spread
+	buckets := make([]ratingBucket, spread)
+	for i := range buckets {
+		buckets[i] = ratingBucket{MinRating: min + i*width, MaxRating: min + (i+1)*width}
+	}
+	buckets[spread-1].MaxRating = max
+	return buckets
+}
+
+// bucketIndex returns which of spread equal-width buckets over
+// [min, max] rating falls into. It uses the same bucket width as
+// bucketBounds so a problem is always counted into the bucket whose
+// displayed range actually contains its rating.
+func bucketIndex(rating, min, max, spread int) int {
+	width := (max - min) / spread
+	if width <= 0 {
+		return 0
+	}
+	idx := (rating - min) / width
+	if idx < 0 {
+		return 0
+	}
+	if idx >= spread {
+		return spread - 1
+	}
+	return idx
+}
+
+// pickRoundRobin picks the first not-yet-used candidate in candidates
+// whose pool matches the next pool in round-robin order, advancing
+// cursor as it tries each pool.
+func pickRoundRobin(candidates []candidate, poolCount int, cursor *int, used map[string]bool) (providers.Problem, bool) {
+	for attempt := 0; attempt < poolCount; attempt++ {
+		wantPool := *cursor % poolCount
+		*cursor++
+		for _, c := range candidates {
+			if c.poolIndex != wantPool {
+				continue
+			}
+			key := problemKey(c.problem)
+			if used[key] {
+				continue
+			}
+			used[key] = true
+			return c.problem, true
+		}
+	}
+	// None of the pools had an unused candidate in round-robin order;
+	// fall back to any unused candidate in this bucket.
+	for _, c := range candidates {
+		key := problemKey(c.problem)
+		if !used[key] {
+			used[key] = true
+			return c.problem, true
+		}
+	}
+	return providers.Problem{}, false
+}
+
+// pickFromNearestBucket searches outward from bucket i for the closest
+// bucket with an unused candidate.
+func pickFromNearestBucket(byBucket [][]candidate, i int, used map[string]bool) (providers.Problem, bool) {
+	for d := 1; d < len(byBucket); d++ {
+		for _, j := range [2]int{i - d, i + d} {
+			if j < 0 || j >= len(byBucket) {
+				continue
+			}
+			for _, c := range byBucket[j] {
+				key := problemKey(c.problem)
+				if used[key] {
+					continue
+				}
+				used[key] = true
+				return c.problem, true
+			}
+		}
+	}
+	return providers.Problem{}, false
+}
+
+// problemKey identifies a problem uniquely enough to dedupe selections.
+func problemKey(p providers.Problem) string {
+	return p.ProblemSetName + "|" + p.Index + "|" + p.Name
+}
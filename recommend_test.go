@@ -0,0 +1,122 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/soorya38/problem-discovery-service/providers"
+)
+
+func TestBucketBoundsAndIndexAgree(t *testing.T) {
+	tests := []struct {
+		name            string
+		min, max        int
+		spread          int
+		ratingsInBucket map[int]int // rating -> expected bucket index
+	}{
+		{
+			name: "evenly divisible",
+			min:  800, max: 1300, spread: 5,
+			ratingsInBucket: map[int]int{800: 0, 899: 0, 999: 1, 1000: 2, 1299: 4, 1300: 4},
+		},
+		{
+			name: "remainder widens last bucket",
+			min:  0, max: 10, spread: 4,
+			ratingsInBucket: map[int]int{0: 0, 7: 3, 9: 3, 10: 3},
+		},
+		{
+			name: "narrow range, spread equal to range",
+			min:  800, max: 805, spread: 5,
+			ratingsInBucket: map[int]int{800: 0, 801: 1, 804: 4, 805: 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buckets := bucketBounds(tt.min, tt.max, tt.spread)
+			if len(buckets) != tt.spread {
+				t.Fatalf("bucketBounds returned %d buckets, want %d", len(buckets), tt.spread)
+			}
+			if buckets[len(buckets)-1].MaxRating != tt.max {
+				t.Fatalf("last bucket MaxRating = %d, want %d", buckets[len(buckets)-1].MaxRating, tt.max)
+			}
+
+			for rating, wantIdx := range tt.ratingsInBucket {
+				gotIdx := bucketIndex(rating, tt.min, tt.max, tt.spread)
+				if gotIdx != wantIdx {
+					t.Errorf("bucketIndex(%d) = %d, want %d", rating, gotIdx, wantIdx)
+					continue
+				}
+				b := buckets[gotIdx]
+				if rating < b.MinRating || rating > b.MaxRating {
+					t.Errorf("rating %d assigned to bucket %d, but that bucket's range is [%d, %d]",
+						rating, gotIdx, b.MinRating, b.MaxRating)
+				}
+			}
+		})
+	}
+}
+
+func problemWithRating(name string, rating int) providers.Problem {
+	return providers.Problem{Name: name, ProblemSetName: "cf", Index: name, Rating: rating}
+}
+
+func TestRecommendSpreadsAcrossBuckets(t *testing.T) {
+	pool := []providers.Problem{
+		problemWithRating("a", 800),
+		problemWithRating("b", 1000),
+		problemWithRating("c", 1200),
+	}
+
+	result := recommend([][]providers.Problem{pool}, 800, 1300, 5)
+
+	if len(result.Histogram) != 5 {
+		t.Fatalf("got %d histogram buckets, want 5", len(result.Histogram))
+	}
+	totalCount := 0
+	for _, b := range result.Histogram {
+		totalCount += b.Count
+	}
+	if totalCount != len(pool) {
+		t.Errorf("histogram counts sum to %d, want %d", totalCount, len(pool))
+	}
+	if len(result.Problems) != len(pool) {
+		t.Errorf("got %d recommended problems, want %d", len(result.Problems), len(pool))
+	}
+}
+
+func TestRecommendRoundRobinsAcrossTagPools(t *testing.T) {
+	// One candidate per pool in each bucket, so both buckets can be
+	// filled without falling back, and round-robin ordering is exercised.
+	dpPool := []providers.Problem{problemWithRating("dp-low", 850), problemWithRating("dp-high", 950)}
+	greedyPool := []providers.Problem{problemWithRating("greedy-low", 850), problemWithRating("greedy-high", 950)}
+
+	result := recommend([][]providers.Problem{dpPool, greedyPool}, 800, 1000, 2)
+
+	if len(result.Problems) != 2 {
+		t.Fatalf("got %d recommended problems, want 2", len(result.Problems))
+	}
+	names := map[string]bool{}
+	for _, p := range result.Problems {
+		names[p.Name] = true
+	}
+	fromDP := names["dp-low"] || names["dp-high"]
+	fromGreedy := names["greedy-low"] || names["greedy-high"]
+	if !fromDP || !fromGreedy {
+		t.Errorf("expected picks from both tag pools, got %v", names)
+	}
+}
+
+func TestRecommendFallsBackToNearestBucketWhenEmpty(t *testing.T) {
+	// All problems fall in the first bucket ([0, 2]); the rest of the
+	// buckets are empty and must fall back to it.
+	pool := []providers.Problem{
+		problemWithRating("a", 0),
+		problemWithRating("b", 1),
+	}
+
+	result := recommend([][]providers.Problem{pool}, 0, 10, 4)
+
+	if len(result.Problems) != len(pool) {
+		t.Fatalf("got %d recommended problems, want %d (one per available candidate)", len(result.Problems), len(pool))
+	}
+}